@@ -7,6 +7,7 @@ import (
 	"go/token"
 	"go/types"
 	htmltemplate "html/template"
+	"math"
 	"net/http"
 	"os"
 	"reflect"
@@ -1949,69 +1950,111 @@ func CheckExtremeComparison(pass *analysis.Pass) (interface{}, error) {
 			return
 		}
 
+		// The bounds of int and uint are those of their 64-bit
+		// variants, so that we only flag comparisons that are
+		// impossible on all platforms.
 		var max string
 		var min string
+		var maxv constant.Value
+		var minv constant.Value
 
 		switch basic.Kind() {
 		case types.Uint8:
 			max = "math.MaxUint8"
+			maxv = constant.MakeUint64(math.MaxUint8)
 		case types.Uint16:
 			max = "math.MaxUint16"
+			maxv = constant.MakeUint64(math.MaxUint16)
 		case types.Uint32:
 			max = "math.MaxUint32"
+			maxv = constant.MakeUint64(math.MaxUint32)
 		case types.Uint64:
 			max = "math.MaxUint64"
+			maxv = constant.MakeUint64(math.MaxUint64)
 		case types.Uint:
 			max = "math.MaxUint64"
+			maxv = constant.MakeUint64(math.MaxUint64)
 
 		case types.Int8:
 			min = "math.MinInt8"
 			max = "math.MaxInt8"
+			minv = constant.MakeInt64(math.MinInt8)
+			maxv = constant.MakeInt64(math.MaxInt8)
 		case types.Int16:
 			min = "math.MinInt16"
 			max = "math.MaxInt16"
+			minv = constant.MakeInt64(math.MinInt16)
+			maxv = constant.MakeInt64(math.MaxInt16)
 		case types.Int32:
 			min = "math.MinInt32"
 			max = "math.MaxInt32"
+			minv = constant.MakeInt64(math.MinInt32)
+			maxv = constant.MakeInt64(math.MaxInt32)
 		case types.Int64:
 			min = "math.MinInt64"
 			max = "math.MaxInt64"
+			minv = constant.MakeInt64(math.MinInt64)
+			maxv = constant.MakeInt64(math.MaxInt64)
 		case types.Int:
 			min = "math.MinInt64"
 			max = "math.MaxInt64"
+			minv = constant.MakeInt64(math.MinInt64)
+			maxv = constant.MakeInt64(math.MaxInt64)
 		}
 
-		if (expr.Op == token.GTR || expr.Op == token.GEQ) && isobj(expr.Y, max) ||
-			(expr.Op == token.LSS || expr.Op == token.LEQ) && isobj(expr.X, max) {
-			report.Report(pass, expr, fmt.Sprintf("no value of type %s is greater than %s", basic, max))
+		// We only consider the named constants from the math package
+		// and integer literals. Other constants may have different
+		// values depending on build tags.
+		isMax := func(expr ast.Expr) bool {
+			if maxv == nil {
+				return false
+			}
+			return isobj(expr, max) || code.IsIntegerLiteral(pass, expr, maxv)
 		}
-		if expr.Op == token.LEQ && isobj(expr.Y, max) ||
-			expr.Op == token.GEQ && isobj(expr.X, max) {
-			report.Report(pass, expr, fmt.Sprintf("every value of type %s is <= %s", basic, max))
+		isMin := func(expr ast.Expr) bool {
+			if (basic.Info() & types.IsUnsigned) != 0 {
+				return code.IsIntegerLiteral(pass, expr, constant.MakeInt64(0))
+			}
+			if minv == nil {
+				return false
+			}
+			return isobj(expr, min) || code.IsIntegerLiteral(pass, expr, minv)
 		}
 
-		isZeroLiteral := func(expr ast.Expr) bool {
-			return code.IsIntegerLiteral(pass, expr, constant.MakeInt64(0))
+		if (expr.Op == token.GTR || expr.Op == token.GEQ) && isMax(expr.Y) {
+			report.Report(pass, expr, fmt.Sprintf("no value of type %s is greater than %s", basic, report.Render(pass, expr.Y)))
+		}
+		if (expr.Op == token.LSS || expr.Op == token.LEQ) && isMax(expr.X) {
+			report.Report(pass, expr, fmt.Sprintf("no value of type %s is greater than %s", basic, report.Render(pass, expr.X)))
 		}
+		if expr.Op == token.LEQ && isMax(expr.Y) {
+			report.Report(pass, expr, fmt.Sprintf("every value of type %s is <= %s", basic, report.Render(pass, expr.Y)))
+		}
+		if expr.Op == token.GEQ && isMax(expr.X) {
+			report.Report(pass, expr, fmt.Sprintf("every value of type %s is <= %s", basic, report.Render(pass, expr.X)))
+		}
+
 		if (basic.Info() & types.IsUnsigned) != 0 {
-			if (expr.Op == token.LSS && isZeroLiteral(expr.Y)) ||
-				(expr.Op == token.GTR && isZeroLiteral(expr.X)) {
-				report.Report(pass, expr, fmt.Sprintf("no value of type %s is less than 0", basic))
+			if expr.Op == token.LSS && isMin(expr.Y) {
+				report.Report(pass, expr, fmt.Sprintf("no value of type %s is less than %s", basic, report.Render(pass, expr.Y)))
 			}
-			if expr.Op == token.GEQ && isZeroLiteral(expr.Y) ||
-				expr.Op == token.LEQ && isZeroLiteral(expr.X) {
-				report.Report(pass, expr, fmt.Sprintf("every value of type %s is >= 0", basic))
+			if expr.Op == token.GTR && isMin(expr.X) {
+				report.Report(pass, expr, fmt.Sprintf("no value of type %s is less than %s", basic, report.Render(pass, expr.X)))
 			}
 		} else {
-			if (expr.Op == token.LSS || expr.Op == token.LEQ) && isobj(expr.Y, min) ||
-				(expr.Op == token.GTR || expr.Op == token.GEQ) && isobj(expr.X, min) {
-				report.Report(pass, expr, fmt.Sprintf("no value of type %s is less than %s", basic, min))
+			if (expr.Op == token.LSS || expr.Op == token.LEQ) && isMin(expr.Y) {
+				report.Report(pass, expr, fmt.Sprintf("no value of type %s is less than %s", basic, report.Render(pass, expr.Y)))
 			}
-			if expr.Op == token.GEQ && isobj(expr.Y, min) ||
-				expr.Op == token.LEQ && isobj(expr.X, min) {
-				report.Report(pass, expr, fmt.Sprintf("every value of type %s is >= %s", basic, min))
+			if (expr.Op == token.GTR || expr.Op == token.GEQ) && isMin(expr.X) {
+				report.Report(pass, expr, fmt.Sprintf("no value of type %s is less than %s", basic, report.Render(pass, expr.X)))
 			}
 		}
+		if expr.Op == token.GEQ && isMin(expr.Y) {
+			report.Report(pass, expr, fmt.Sprintf("every value of type %s is >= %s", basic, report.Render(pass, expr.Y)))
+		}
+		if expr.Op == token.LEQ && isMin(expr.X) {
+			report.Report(pass, expr, fmt.Sprintf("every value of type %s is >= %s", basic, report.Render(pass, expr.X)))
+		}
 
 	}
 	code.Preorder(pass, fn, (*ast.BinaryExpr)(nil))
@@ -41,3 +41,37 @@ func fn1() {
 	_ = i8 < math.MinInt8  //@ diag(`no value of type int8 is less than math.MinInt8`)
 	_ = i8 >= math.MinInt8 //@ diag(`every value of type int8 is >= math.MinInt8`)
 }
+
+func fn3() {
+	var (
+		u8  uint8
+		u16 uint16
+		u32 uint32
+		u64 uint64
+
+		i8  int8
+		i16 int16
+		i32 int32
+	)
+
+	_ = u8 > 255    //@ diag(`no value of type uint8 is greater than 255`)
+	_ = 255 < u8    //@ diag(`no value of type uint8 is greater than 255`)
+	_ = u8 <= 0xFF  //@ diag(`every value of type uint8 is <= 0xFF`)
+	_ = u16 > 65535 //@ diag(`no value of type uint16 is greater than 65535`)
+	_ = u16 > 255
+	_ = u32 < 0  //@ diag(`no value of type uint32 is less than 0`)
+	_ = u32 >= 0 //@ diag(`every value of type uint32 is >= 0`)
+	_ = u64 < 0  //@ diag(`no value of type uint64 is less than 0`)
+	_ = u64 > 1<<63
+	_ = i8 > 127   //@ diag(`no value of type int8 is greater than 127`)
+	_ = i8 < -128  //@ diag(`no value of type int8 is less than -128`)
+	_ = -128 <= i8 //@ diag(`every value of type int8 is >= -128`)
+	_ = i8 > -128
+	_ = i16 > 32767  //@ diag(`no value of type int16 is greater than 32767`)
+	_ = i16 < -32768 //@ diag(`no value of type int16 is less than -32768`)
+	_ = i32 < 0
+	_ = i32 <= 2147483647 //@ diag(`every value of type int32 is <= 2147483647`)
+
+	const x = 255
+	_ = u8 > x
+}